@@ -0,0 +1,106 @@
+package tracing
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// DatabaseSQL returns a trace.DatabaseSQL that reports OpenTelemetry spans for
+// the database/sql driver of ydb-go-sdk: connection lifecycle, transactions
+// and statement execution. Every span carries a query_type attribute
+// (Connect/Ping/Prepare/Exec/Query/StmtExec/StmtQuery/Begin/Commit/Rollback/Close).
+// StmtExec/StmtQuery cover execution of an already-prepared *sql.Stmt, kept
+// distinct from the Exec/Query of the connection-level, ad-hoc statements and
+// from the Prepare span that created them. Exec/Query/StmtExec/StmtQuery run
+// their bound arguments through the configured Sanitizer (WithSanitizer),
+// same as Scripting.
+func DatabaseSQL(details trace.Details, opts ...Option) (t trace.DatabaseSQL) {
+	o := newOptions(opts...)
+	if details&trace.DatabaseSQLEvents != 0 {
+		t.OnConnectorConnect = func(info trace.DatabaseSQLConnectorConnectStartInfo) func(trace.DatabaseSQLConnectorConnectDoneInfo) {
+			start := startSpan(info.Context, "ydb_database_sql_connect", attribute.String("query_type", "Connect"))
+			return func(info trace.DatabaseSQLConnectorConnectDoneInfo) {
+				finish(start, info.Error)
+			}
+		}
+		t.OnConnPing = func(info trace.DatabaseSQLConnPingStartInfo) func(trace.DatabaseSQLConnPingDoneInfo) {
+			start := startSpan(info.Context, "ydb_database_sql_ping", attribute.String("query_type", "Ping"))
+			return func(info trace.DatabaseSQLConnPingDoneInfo) {
+				finish(start, info.Error)
+			}
+		}
+		t.OnConnPrepare = func(info trace.DatabaseSQLConnPrepareStartInfo) func(trace.DatabaseSQLConnPrepareDoneInfo) {
+			start := startSpan(
+				info.Context,
+				"ydb_database_sql_prepare",
+				append([]attribute.KeyValue{attribute.String("query_type", "Prepare")},
+					o.queryAttributes(info.Context, "Prepare", info.Query)...)...,
+			)
+			return func(info trace.DatabaseSQLConnPrepareDoneInfo) {
+				finish(start, info.Error)
+			}
+		}
+		t.OnConnExec = func(info trace.DatabaseSQLConnExecStartInfo) func(trace.DatabaseSQLConnExecDoneInfo) {
+			attrs := append([]attribute.KeyValue{attribute.String("query_type", "Exec")},
+				o.queryAttributes(info.Context, "Exec", info.Query)...)
+			attrs = append(attrs, o.paramsAttribute(info.Args))
+			start := startSpan(info.Context, "ydb_database_sql_exec", attrs...)
+			return func(info trace.DatabaseSQLConnExecDoneInfo) {
+				finish(start, info.Error)
+			}
+		}
+		t.OnConnQuery = func(info trace.DatabaseSQLConnQueryStartInfo) func(trace.DatabaseSQLConnQueryDoneInfo) {
+			attrs := append([]attribute.KeyValue{attribute.String("query_type", "Query")},
+				o.queryAttributes(info.Context, "Query", info.Query)...)
+			attrs = append(attrs, o.paramsAttribute(info.Args))
+			start := startSpan(info.Context, "ydb_database_sql_query", attrs...)
+			return func(info trace.DatabaseSQLConnQueryDoneInfo) {
+				finish(start, info.Error)
+			}
+		}
+		t.OnStmtExec = func(info trace.DatabaseSQLStmtExecStartInfo) func(trace.DatabaseSQLStmtExecDoneInfo) {
+			attrs := append([]attribute.KeyValue{attribute.String("query_type", "StmtExec")},
+				o.queryAttributes(info.Context, "StmtExec", info.Query)...)
+			attrs = append(attrs, o.paramsAttribute(info.Args))
+			start := startSpan(info.Context, "ydb_database_sql_stmt_exec", attrs...)
+			return func(info trace.DatabaseSQLStmtExecDoneInfo) {
+				finish(start, info.Error)
+			}
+		}
+		t.OnStmtQuery = func(info trace.DatabaseSQLStmtQueryStartInfo) func(trace.DatabaseSQLStmtQueryDoneInfo) {
+			attrs := append([]attribute.KeyValue{attribute.String("query_type", "StmtQuery")},
+				o.queryAttributes(info.Context, "StmtQuery", info.Query)...)
+			attrs = append(attrs, o.paramsAttribute(info.Args))
+			start := startSpan(info.Context, "ydb_database_sql_stmt_query", attrs...)
+			return func(info trace.DatabaseSQLStmtQueryDoneInfo) {
+				finish(start, info.Error)
+			}
+		}
+		t.OnConnBegin = func(info trace.DatabaseSQLConnBeginStartInfo) func(trace.DatabaseSQLConnBeginDoneInfo) {
+			start := startSpan(info.Context, "ydb_database_sql_begin", attribute.String("query_type", "Begin"))
+			return func(info trace.DatabaseSQLConnBeginDoneInfo) {
+				finish(start, info.Error)
+			}
+		}
+		t.OnTxCommit = func(info trace.DatabaseSQLTxCommitStartInfo) func(trace.DatabaseSQLTxCommitDoneInfo) {
+			start := startSpan(info.Context, "ydb_database_sql_commit", attribute.String("query_type", "Commit"))
+			return func(info trace.DatabaseSQLTxCommitDoneInfo) {
+				finish(start, info.Error)
+			}
+		}
+		t.OnTxRollback = func(info trace.DatabaseSQLTxRollbackStartInfo) func(trace.DatabaseSQLTxRollbackDoneInfo) {
+			start := startSpan(info.Context, "ydb_database_sql_rollback", attribute.String("query_type", "Rollback"))
+			return func(info trace.DatabaseSQLTxRollbackDoneInfo) {
+				finish(start, info.Error)
+			}
+		}
+		t.OnConnClose = func(info trace.DatabaseSQLConnCloseStartInfo) func(trace.DatabaseSQLConnCloseDoneInfo) {
+			start := startSpan(info.Context, "ydb_database_sql_close", attribute.String("query_type", "Close"))
+			return func(info trace.DatabaseSQLConnCloseDoneInfo) {
+				finish(start, info.Error)
+			}
+		}
+	}
+	return t
+}
@@ -0,0 +1,90 @@
+package metrics
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Metrics holds the OpenTelemetry instruments recorded from the YDB driver's
+// trace.Details callbacks, mirroring the spans produced by the tracing
+// package with the same instrument for every call site.
+type Metrics struct {
+	queryDuration    metric.Float64Histogram
+	retryAttempts    metric.Int64Counter
+	errorsTotal      metric.Int64Counter
+	sessionPoolInUse metric.Int64UpDownCounter
+
+	mu           sync.Mutex
+	lastPoolSize int64
+}
+
+// New creates a Metrics using the meter named after this module, registering
+// every instrument up front so callers can start recording immediately.
+func New(meterProvider metric.MeterProvider) *Metrics {
+	meter := meterProvider.Meter("github.com/ydb-platform/ydb-go-sdk-opentelemetry")
+
+	queryDuration, _ := meter.Float64Histogram(
+		"ydb.query.duration",
+		metric.WithDescription("Duration of YDB operations, by operation type"),
+		metric.WithUnit("ms"),
+	)
+
+	retryAttempts, _ := meter.Int64Counter(
+		"ydb.retry.attempts",
+		metric.WithDescription("Number of retry attempts made by the YDB driver, by reason"),
+	)
+
+	errorsTotal, _ := meter.Int64Counter(
+		"ydb.errors.total",
+		metric.WithDescription("Number of errors observed by the YDB driver, by error class"),
+	)
+
+	sessionPoolInUse, _ := meter.Int64UpDownCounter(
+		"ydb.session_pool.in_use",
+		metric.WithDescription("Number of sessions currently held by the YDB session pool"),
+	)
+
+	return &Metrics{
+		queryDuration:    queryDuration,
+		retryAttempts:    retryAttempts,
+		errorsTotal:      errorsTotal,
+		sessionPoolInUse: sessionPoolInUse,
+	}
+}
+
+// RecordQueryDuration records how long an operation of the given type took.
+func (m *Metrics) RecordQueryDuration(ctx context.Context, operationType string, seconds float64) {
+	m.queryDuration.Record(ctx, seconds*1000, metric.WithAttributes(
+		attribute.String("ydb.operation_type", operationType),
+	))
+}
+
+// IncRetryAttempt increments the retry attempt counter for the given reason.
+func (m *Metrics) IncRetryAttempt(ctx context.Context, reason string) {
+	m.retryAttempts.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("retry.reason", reason),
+	))
+}
+
+// IncError increments the error counter for the given error class.
+func (m *Metrics) IncError(ctx context.Context, class string) {
+	m.errorsTotal.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("error.class", class),
+	))
+}
+
+// SetSessionPoolSize reports the current size of the session pool, adjusting
+// the ydb.session_pool.in_use gauge by the delta since the last observation.
+func (m *Metrics) SetSessionPoolSize(ctx context.Context, size int64) {
+	m.mu.Lock()
+	delta := size - m.lastPoolSize
+	m.lastPoolSize = size
+	m.mu.Unlock()
+
+	if delta != 0 {
+		m.sessionPoolInUse.Add(ctx, delta)
+	}
+}
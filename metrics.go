@@ -0,0 +1,158 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+
+	"github.com/ydb-platform/ydb-go-sdk-opentelemetry/internal/metrics"
+)
+
+// RetryMetrics returns a trace.Retry that records retry attempt counts and
+// loop durations, without emitting any spans. Combine it with Retry when a
+// user wants both traces and metrics for the same events. Attempts are
+// tagged with the same retry.reason taxonomy as the ydb_retry span, via
+// classifyRetryReason.
+func RetryMetrics(m *metrics.Metrics, details trace.Details) (t trace.Retry) {
+	if details&trace.RetryEvents != 0 {
+		t.OnRetry = func(info trace.RetryLoopStartInfo) func(trace.RetryLoopIntermediateInfo) func(trace.RetryLoopDoneInfo) {
+			ctx := info.Context
+			start := time.Now()
+			return func(info trace.RetryLoopIntermediateInfo) func(trace.RetryLoopDoneInfo) {
+				if info.Error != nil {
+					m.IncRetryAttempt(ctx, classifyRetryReason(info.Error))
+				}
+				return func(info trace.RetryLoopDoneInfo) {
+					m.RecordQueryDuration(ctx, "retry", time.Since(start).Seconds())
+				}
+			}
+		}
+	}
+	return t
+}
+
+// ScriptingMetrics returns a trace.Scripting that records operation durations
+// and error counts, without emitting any spans.
+func ScriptingMetrics(m *metrics.Metrics, details trace.Details) (t trace.Scripting) {
+	if details&trace.ScriptingEvents != 0 {
+		t.OnExecute = func(info trace.ScriptingExecuteStartInfo) func(trace.ScriptingExecuteDoneInfo) {
+			ctx := info.Context
+			start := time.Now()
+			return func(info trace.ScriptingExecuteDoneInfo) {
+				m.RecordQueryDuration(ctx, "scripting_execute", time.Since(start).Seconds())
+				if info.Error != nil {
+					m.IncError(ctx, errorClass(info.Error))
+				}
+			}
+		}
+		t.OnStreamExecute = func(
+			info trace.ScriptingStreamExecuteStartInfo,
+		) func(
+			trace.ScriptingStreamExecuteIntermediateInfo,
+		) func(
+			trace.ScriptingStreamExecuteDoneInfo,
+		) {
+			ctx := info.Context
+			start := time.Now()
+			return func(info trace.ScriptingStreamExecuteIntermediateInfo) func(trace.ScriptingStreamExecuteDoneInfo) {
+				return func(info trace.ScriptingStreamExecuteDoneInfo) {
+					m.RecordQueryDuration(ctx, "scripting_stream_execute", time.Since(start).Seconds())
+					if info.Error != nil {
+						m.IncError(ctx, errorClass(info.Error))
+					}
+				}
+			}
+		}
+	}
+	return t
+}
+
+// DatabaseSQLMetrics returns a trace.DatabaseSQL that records operation
+// durations and error counts for the database/sql driver, without emitting
+// any spans. It covers the same Prepare/Exec/Query/StmtExec/StmtQuery
+// callbacks that DatabaseSQL instruments.
+func DatabaseSQLMetrics(m *metrics.Metrics, details trace.Details) (t trace.DatabaseSQL) {
+	if details&trace.DatabaseSQLEvents != 0 {
+		record := func(ctx context.Context, operationType string, start time.Time, err error) {
+			m.RecordQueryDuration(ctx, operationType, time.Since(start).Seconds())
+			if err != nil {
+				m.IncError(ctx, errorClass(err))
+			}
+		}
+		t.OnConnPrepare = func(info trace.DatabaseSQLConnPrepareStartInfo) func(trace.DatabaseSQLConnPrepareDoneInfo) {
+			ctx, start := info.Context, time.Now()
+			return func(info trace.DatabaseSQLConnPrepareDoneInfo) {
+				record(ctx, "database_sql_prepare", start, info.Error)
+			}
+		}
+		t.OnConnExec = func(info trace.DatabaseSQLConnExecStartInfo) func(trace.DatabaseSQLConnExecDoneInfo) {
+			ctx, start := info.Context, time.Now()
+			return func(info trace.DatabaseSQLConnExecDoneInfo) {
+				record(ctx, "database_sql_exec", start, info.Error)
+			}
+		}
+		t.OnConnQuery = func(info trace.DatabaseSQLConnQueryStartInfo) func(trace.DatabaseSQLConnQueryDoneInfo) {
+			ctx, start := info.Context, time.Now()
+			return func(info trace.DatabaseSQLConnQueryDoneInfo) {
+				record(ctx, "database_sql_query", start, info.Error)
+			}
+		}
+		t.OnStmtExec = func(info trace.DatabaseSQLStmtExecStartInfo) func(trace.DatabaseSQLStmtExecDoneInfo) {
+			ctx, start := info.Context, time.Now()
+			return func(info trace.DatabaseSQLStmtExecDoneInfo) {
+				record(ctx, "database_sql_stmt_exec", start, info.Error)
+			}
+		}
+		t.OnStmtQuery = func(info trace.DatabaseSQLStmtQueryStartInfo) func(trace.DatabaseSQLStmtQueryDoneInfo) {
+			ctx, start := info.Context, time.Now()
+			return func(info trace.DatabaseSQLStmtQueryDoneInfo) {
+				record(ctx, "database_sql_stmt_query", start, info.Error)
+			}
+		}
+	}
+	return t
+}
+
+// PoolMetrics returns a trace.Table that reports the session pool's size on
+// the ydb.session_pool.in_use gauge whenever it changes.
+func PoolMetrics(m *metrics.Metrics, details trace.Details) (t trace.Table) {
+	if details&trace.TablePoolEvents != 0 {
+		t.OnPoolStateChange = func(info trace.PoolStateChangeInfo) {
+			m.SetSessionPoolSize(context.Background(), int64(info.Size))
+		}
+	}
+	return t
+}
+
+// WithMetrics is the metrics counterpart of WithTraces: it builds the same
+// ydb.Option shape, wired to record OpenTelemetry metrics on meterProvider
+// instead of spans, so it can be passed to ydb.Open alongside or instead of
+// WithTraces.
+func WithMetrics(meterProvider metric.MeterProvider, details trace.Details) ydb.Option {
+	m := metrics.New(meterProvider)
+	return ydb.MergeOptions(
+		ydb.WithTraceRetry(RetryMetrics(m, details)),
+		ydb.WithTraceScripting(ScriptingMetrics(m, details)),
+		ydb.WithTraceDatabaseSQL(DatabaseSQLMetrics(m, details)),
+		ydb.WithTraceTable(PoolMetrics(m, details)),
+	)
+}
+
+// errorClass buckets a generic driver error for the error.class metric
+// label. Unlike classifyRetryReason, it is not specific to retry semantics.
+func errorClass(err error) string {
+	switch {
+	case err == nil:
+		return ""
+	case ydb.IsTransportError(err):
+		return "transport"
+	case ydb.IsOperationErrorOverloaded(err):
+		return "overloaded"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,144 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type options struct {
+	semanticConventions bool
+	sanitizeQuery       func(string) string
+	sanitizer           Sanitizer
+}
+
+// Option configures the span attributes produced by Scripting and
+// DatabaseSQL.
+type Option func(*options)
+
+// WithSemanticConventions toggles OpenTelemetry database semantic-convention
+// attribute names (db.system, db.statement, db.operation) instead of this
+// package's original ad-hoc names ("query", "params"). It defaults to
+// disabled so existing consumers keep seeing the attributes they already
+// rely on.
+func WithSemanticConventions(enabled bool) Option {
+	return func(o *options) {
+		o.semanticConventions = enabled
+	}
+}
+
+// WithQuerySanitizer overrides how query text is rewritten before being
+// attached to a span as db.statement/query, e.g. to strip literal values and
+// avoid leaking PII. The default keeps the query unmodified.
+func WithQuerySanitizer(sanitize func(string) string) Option {
+	return func(o *options) {
+		o.sanitizeQuery = sanitize
+	}
+}
+
+// WithSanitizer controls how query parameters are recorded on spans, e.g.
+// tracing.WithSanitizer(tracing.TypesOnly) to log parameter names and types
+// without their values. Defaults to Full, matching this package's historical
+// behavior.
+func WithSanitizer(s Sanitizer) Option {
+	return func(o *options) {
+		o.sanitizer = s
+	}
+}
+
+func newOptions(opts ...Option) *options {
+	o := &options{
+		sanitizeQuery: func(query string) string { return query },
+		sanitizer:     Full,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// queryAttributes builds the span attributes describing a query, following
+// either this package's legacy naming or OpenTelemetry's database semantic
+// conventions, depending on o.semanticConventions. Under semantic
+// conventions, it also attaches whatever DatabaseMetadata has been tagged on
+// ctx via WithDatabaseMetadata (session id, tx id, database name, peer).
+func (o *options) queryAttributes(ctx context.Context, operation, query string) []attribute.KeyValue {
+	query = o.sanitizeQuery(query)
+	if o.semanticConventions {
+		return append([]attribute.KeyValue{
+			attribute.String("db.system", "ydb"),
+			attribute.String("db.operation", operation),
+			attribute.String("db.statement", query),
+		}, databaseMetadataAttributes(ctx)...)
+	}
+	return []attribute.KeyValue{
+		attribute.String("query", query),
+	}
+}
+
+// paramsAttribute reports the sanitized query parameters/args under the key
+// that matches the active naming scheme, so WithSanitizer keeps taking
+// effect even when WithSemanticConventions(true) is also set. Used by both
+// Scripting and DatabaseSQL wherever a handler records parameter values.
+func (o *options) paramsAttribute(params interface{}) attribute.KeyValue {
+	key := "params"
+	if o.semanticConventions {
+		key = "db.ydb.params"
+	}
+	return attribute.String(key, o.sanitizer.Sanitize(params))
+}
+
+type databaseMetadataKey struct{}
+
+// DatabaseMetadata carries connection-level details that aren't available on
+// the trace.Details callbacks themselves, so that Scripting and DatabaseSQL
+// can attach them as db.name, db.ydb.session_id, db.ydb.tx_id, net.peer.name
+// and net.peer.port when semantic conventions are enabled.
+//
+// This package has no access to the session/transaction/endpoint on its own:
+// none of the trace.ScriptingXxxInfo/trace.DatabaseSQLXxxInfo structs expose
+// them, so nothing in this repo populates DatabaseMetadata automatically.
+// Callers that want these five attributes must call WithDatabaseMetadata
+// themselves on the context passed into the traced call, typically inside
+// their own session/transaction wrapper right after they learn the session
+// or transaction id. See ExampleWithDatabaseMetadata. Until the SDK exposes
+// this data on the callbacks, WithSemanticConventions(true) alone will not
+// produce db.name/db.ydb.session_id/db.ydb.tx_id/net.peer.name/net.peer.port.
+type DatabaseMetadata struct {
+	Name      string
+	SessionID string
+	TxID      string
+	PeerName  string
+	PeerPort  int
+}
+
+// WithDatabaseMetadata tags ctx with DatabaseMetadata for the span(s) created
+// from it to pick up. It must be called explicitly by the caller for every
+// operation whose metadata should be recorded; see DatabaseMetadata.
+func WithDatabaseMetadata(ctx context.Context, meta DatabaseMetadata) context.Context {
+	return context.WithValue(ctx, databaseMetadataKey{}, meta)
+}
+
+func databaseMetadataAttributes(ctx context.Context) []attribute.KeyValue {
+	meta, ok := ctx.Value(databaseMetadataKey{}).(DatabaseMetadata)
+	if !ok {
+		return nil
+	}
+	var attrs []attribute.KeyValue
+	if meta.Name != "" {
+		attrs = append(attrs, attribute.String("db.name", meta.Name))
+	}
+	if meta.SessionID != "" {
+		attrs = append(attrs, attribute.String("db.ydb.session_id", meta.SessionID))
+	}
+	if meta.TxID != "" {
+		attrs = append(attrs, attribute.String("db.ydb.tx_id", meta.TxID))
+	}
+	if meta.PeerName != "" {
+		attrs = append(attrs, attribute.String("net.peer.name", meta.PeerName))
+	}
+	if meta.PeerPort != 0 {
+		attrs = append(attrs, attribute.Int("net.peer.port", meta.PeerPort))
+	}
+	return attrs
+}
@@ -0,0 +1,28 @@
+package tracing_test
+
+import (
+	"context"
+	"fmt"
+
+	tracing "github.com/ydb-platform/ydb-go-sdk-opentelemetry"
+)
+
+// ExampleWithDatabaseMetadata shows how to populate the db.name,
+// db.ydb.session_id, db.ydb.tx_id, net.peer.name and net.peer.port
+// attributes that WithSemanticConventions(true) alone cannot produce: the
+// caller tags the context right before the traced call, typically from a
+// session/transaction wrapper that already knows these values.
+func ExampleWithDatabaseMetadata() {
+	ctx := tracing.WithDatabaseMetadata(context.Background(), tracing.DatabaseMetadata{
+		Name:      "/local",
+		SessionID: "session-1",
+		TxID:      "tx-1",
+		PeerName:  "ydb.example.com",
+		PeerPort:  2136,
+	})
+
+	// ctx now carries the metadata; pass it into the call whose
+	// trace.Details callbacks should report it, e.g. session.Execute(ctx, ...).
+	fmt.Println(ctx != context.Background())
+	// Output: true
+}
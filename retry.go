@@ -1,11 +1,33 @@
-package ydb
+package tracing
 
 import (
+	"context"
+	"errors"
+	"time"
+
 	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
+type operationTypeKey struct{}
+
+// WithOperationType tags ctx with the kind of operation (e.g. "query", "exec",
+// "prepare" or "bulk_upsert") that is about to be retried, so that Retry can
+// report it as the ydb.operation_type attribute on the ydb_retry span.
+func WithOperationType(ctx context.Context, operationType string) context.Context {
+	return context.WithValue(ctx, operationTypeKey{}, operationType)
+}
+
+func operationTypeFromContext(ctx context.Context) string {
+	if v, ok := ctx.Value(operationTypeKey{}).(string); ok && v != "" {
+		return v
+	}
+	return "unknown"
+}
+
 func Retry(details trace.Details) (t trace.Retry) {
 	if details&trace.RetryEvents != 0 {
 		t.OnRetry = func(info trace.RetryLoopStartInfo) func(trace.RetryLoopIntermediateInfo) func(trace.RetryLoopDoneInfo) {
@@ -13,8 +35,30 @@ func Retry(details trace.Details) (t trace.Retry) {
 				info.Context,
 				"ydb_retry",
 				attribute.Bool("idempotent", info.Idempotent),
+				attribute.String("ydb.operation_type", operationTypeFromContext(info.Context)),
 			)
+			attempt := 0
+			lastAttempt := time.Now()
 			return func(info trace.RetryLoopIntermediateInfo) func(trace.RetryLoopDoneInfo) {
+				attempt++
+				// sinceLastAttempt spans the whole gap since the previous
+				// attempt returned (for the first attempt, since the retry
+				// loop started), which includes that attempt's own
+				// execution time (RTT, server processing) as well as any
+				// backoff sleep. trace.RetryLoopIntermediateInfo does not
+				// expose the backoff duration the SDK actually slept for, so
+				// this is named and documented as the coarser interval
+				// rather than mislabeled as a pure backoff delay.
+				now := time.Now()
+				sinceLastAttempt := now.Sub(lastAttempt)
+				lastAttempt = now
+				reason := classifyRetryReason(info.Error)
+				start.AddEvent("retry.attempt", oteltrace.WithAttributes(
+					attribute.Int("retry.iteration", attempt),
+					attribute.Int64("retry.time_since_previous_attempt_ms", sinceLastAttempt.Milliseconds()),
+					attribute.String("retry.reason", reason),
+					attribute.Bool("retry.retryable", reason != "" && reason != "aborted"),
+				))
 				intermediate(start, info.Error)
 				return func(info trace.RetryLoopDoneInfo) {
 					finish(start,
@@ -27,3 +71,20 @@ func Retry(details trace.Details) (t trace.Retry) {
 	}
 	return t
 }
+
+// classifyRetryReason buckets a retry attempt's error into the coarse
+// categories reported as the retry.reason span attribute.
+func classifyRetryReason(err error) string {
+	switch {
+	case err == nil:
+		return ""
+	case ydb.IsTransportError(err):
+		return "transport"
+	case ydb.IsOperationErrorOverloaded(err):
+		return "overloaded"
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return "aborted"
+	default:
+		return "transient"
+	}
+}
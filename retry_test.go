@@ -0,0 +1,28 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestClassifyRetryReason(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"nil", nil, ""},
+		{"deadline exceeded", context.DeadlineExceeded, "aborted"},
+		{"canceled", context.Canceled, "aborted"},
+		{"deadline exceeded text without wrapping", errors.New("op failed: " + context.DeadlineExceeded.Error()), "transient"},
+		{"generic error", errors.New("boom"), "transient"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := classifyRetryReason(tt.err); got != tt.want {
+				t.Errorf("classifyRetryReason(%v) = %q, want %q", tt.err, got, tt.want)
+			}
+		})
+	}
+}
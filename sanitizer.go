@@ -0,0 +1,77 @@
+package tracing
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk-opentelemetry/internal/safe"
+)
+
+// Sanitizer controls how query parameters are recorded on spans. Implementations
+// trade off observability against the risk of leaking user data into traces.
+type Sanitizer interface {
+	Sanitize(params interface{}) string
+}
+
+type sanitizerFunc func(params interface{}) string
+
+func (f sanitizerFunc) Sanitize(params interface{}) string { return f(params) }
+
+// NoParams omits parameter values entirely.
+var NoParams Sanitizer = sanitizerFunc(func(interface{}) string {
+	return ""
+})
+
+// Full records parameters verbatim, matching this package's historical
+// behavior. Only use it when traces are not shared outside of a trusted
+// boundary.
+var Full Sanitizer = sanitizerFunc(func(params interface{}) string {
+	return safe.Stringer(params)
+})
+
+// paramToken matches a single "$name:Type:" marker in a parameter's default
+// string representation, delimiting where that parameter's value starts. The
+// first submatch is the name, the second the declared type.
+var paramToken = regexp.MustCompile(`\$([A-Za-z0-9_]+):([A-Za-z0-9_<>?]+):`)
+
+// TypesOnly records parameter names and their declared types, omitting
+// values.
+var TypesOnly Sanitizer = sanitizerFunc(func(params interface{}) string {
+	raw := safe.Stringer(params)
+	matches := paramToken.FindAllStringSubmatch(raw, -1)
+	tokens := make([]string, 0, len(matches))
+	for _, m := range matches {
+		tokens = append(tokens, "$"+m[1]+":"+m[2])
+	}
+	return strings.Join(tokens, ",")
+})
+
+// HashValues replaces each parameter's value with a short stable hash of
+// just that value, keeping parameter names visible. Because each value is
+// hashed independently, two calls can still be compared per parameter, and
+// changing one parameter doesn't change the hash of the others.
+var HashValues Sanitizer = sanitizerFunc(func(params interface{}) string {
+	raw := safe.Stringer(params)
+
+	locs := paramToken.FindAllStringSubmatchIndex(raw, -1)
+	if len(locs) == 0 {
+		sum := sha256.Sum256([]byte(raw))
+		return hex.EncodeToString(sum[:8])
+	}
+
+	parts := make([]string, 0, len(locs))
+	for i, loc := range locs {
+		name := raw[loc[2]:loc[3]]
+		valueStart := loc[1]
+		valueEnd := len(raw)
+		if i+1 < len(locs) {
+			valueEnd = locs[i+1][0]
+		}
+		value := strings.Trim(raw[valueStart:valueEnd], ", ")
+		sum := sha256.Sum256([]byte(value))
+		parts = append(parts, "$"+name+"="+hex.EncodeToString(sum[:8]))
+	}
+	return strings.Join(parts, ",")
+})
@@ -0,0 +1,55 @@
+package tracing
+
+import (
+	"strings"
+	"testing"
+)
+
+type stringerParams string
+
+func (s stringerParams) String() string { return string(s) }
+
+const sampleParams = stringerParams(`$seriesID:Uint64:1,$title:Utf8:"hello"`)
+
+func TestSanitizers(t *testing.T) {
+	tests := []struct {
+		name string
+		s    Sanitizer
+		want string
+	}{
+		{"NoParams", NoParams, ""},
+		{"Full", Full, string(sampleParams)},
+		{"TypesOnly", TypesOnly, "$seriesID:Uint64,$title:Utf8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.s.Sanitize(sampleParams); got != tt.want {
+				t.Errorf("%s.Sanitize(%q) = %q, want %q", tt.name, sampleParams, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHashValuesHashesEachParameterIndependently(t *testing.T) {
+	a := stringerParams(`$x:Uint64:1,$y:Uint64:2`)
+	b := stringerParams(`$x:Uint64:1,$y:Uint64:3`)
+
+	hashedA := HashValues.Sanitize(a)
+	hashedB := HashValues.Sanitize(b)
+
+	xA := strings.Split(hashedA, ",")[0]
+	xB := strings.Split(hashedB, ",")[0]
+	if xA != xB {
+		t.Errorf("hash for unchanged parameter $x changed between calls: %q vs %q", xA, xB)
+	}
+	if hashedA == hashedB {
+		t.Errorf("expected the overall output to differ when $y's value changes")
+	}
+}
+
+func TestHashValuesOmitsValues(t *testing.T) {
+	got := HashValues.Sanitize(sampleParams)
+	if strings.Contains(got, "hello") {
+		t.Errorf("HashValues.Sanitize(%q) = %q, leaked the raw value", sampleParams, got)
+	}
+}
@@ -5,14 +5,16 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
-func Scripting(details trace.Details) (t trace.Scripting) {
+func Scripting(details trace.Details, opts ...Option) (t trace.Scripting) {
+	o := newOptions(opts...)
 	if details&trace.ScriptingEvents != 0 {
 		t.OnExecute = func(info trace.ScriptingExecuteStartInfo) func(trace.ScriptingExecuteDoneInfo) {
+			attrs := o.queryAttributes(info.Context, "ScriptingExecute", info.Query)
+			attrs = append(attrs, o.paramsAttribute(info.Parameters))
 			start := startSpan(
 				info.Context,
 				"ydb_scripting_execute",
-				otlog.String("query", info.Query),
-				otlog.String("params", safe.Stringer(info.Parameters)),
+				attrs...,
 			)
 			return func(info trace.ScriptingExecuteDoneInfo) {
 				if info.Error == nil {
@@ -35,11 +37,12 @@ func Scripting(details trace.Details) (t trace.Scripting) {
 		) func(
 			trace.ScriptingStreamExecuteDoneInfo,
 		) {
+			attrs := o.queryAttributes(info.Context, "ScriptingStreamExecute", info.Query)
+			attrs = append(attrs, o.paramsAttribute(info.Parameters))
 			start := startSpan(
 				info.Context,
 				"ydb_scripting_stream_execute",
-				otlog.String("query", info.Query),
-				otlog.String("params", safe.Stringer(info.Parameters)),
+				attrs...,
 			)
 			return func(
 				info trace.ScriptingStreamExecuteIntermediateInfo,
@@ -56,7 +59,7 @@ func Scripting(details trace.Details) (t trace.Scripting) {
 			start := startSpan(
 				info.Context,
 				"ydb_scripting_explain",
-				otlog.String("query", info.Query),
+				o.queryAttributes(info.Context, "ScriptingExplain", info.Query)...,
 			)
 			return func(info trace.ScriptingExplainDoneInfo) {
 				finish(start, info.Error)
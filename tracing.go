@@ -0,0 +1,17 @@
+package tracing
+
+import (
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// WithTraces builds the ydb.Option for ydb.Open that reports OpenTelemetry
+// spans for retries, Scripting and the database/sql driver. Combine it with
+// WithMetrics to also record metrics for the same events.
+func WithTraces(details trace.Details, opts ...Option) ydb.Option {
+	return ydb.MergeOptions(
+		ydb.WithTraceRetry(Retry(details)),
+		ydb.WithTraceScripting(Scripting(details, opts...)),
+		ydb.WithTraceDatabaseSQL(DatabaseSQL(details, opts...)),
+	)
+}